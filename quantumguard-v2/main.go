@@ -2,26 +2,86 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
+	"github.com/your-org/quantumguard-v2/pkg/arf"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
 	"github.com/your-org/quantumguard-v2/pkg/quantumguard"
+	"github.com/your-org/quantumguard-v2/pkg/telemetry"
 )
 
 func main() {
 	qg := quantumguard.MustBuild(context.Background())
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Println("shutting down: draining in-flight remediation confirmations...")
+		qg.Close()
+		os.Exit(0)
+	}()
+
 	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, `{"status":"healthy","service":"quantumguard-v2"}`)
 	})
 
+	if handler := metrics.PrometheusHandler(qg.MeterFactory); handler != nil {
+		http.Handle("/metrics", handler)
+	}
+
 	http.HandleFunc("/api/v2/self-compete", func(w http.ResponseWriter, r *http.Request) {
+		ctx := telemetry.ExtractHTTP(r.Context(), r)
+		telemetry.InjectHTTP(ctx, w)
+
 		// Dummy handler to simulate auto-remediation
 		fmt.Fprintln(w, "[AUTO-REMEDY] Scaling auth-service → 5 replicas")
 		fmt.Fprintln(w, "[FINOPS] Billed $0.01 to tenant acme")
 		fmt.Fprintln(w, "[COMPLIANCE] Action auto-certified")
 	})
 
+	// Go 1.21 (the toolchain this repo builds with) predates the 1.22
+	// method-prefixed ServeMux patterns and http.Request.PathValue, so the
+	// action ID is pulled off the path by hand instead of "GET /path/{id}".
+	http.HandleFunc("/api/v2/actions/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/actions/")
+		if id == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		record, err := qg.ActionState(r.Context(), id)
+		if errors.Is(err, arf.ErrActionNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(record)
+	})
+
+	http.HandleFunc("/api/v2/policies", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		json.NewEncoder(w).Encode(qg.Compliance.Policies())
+	})
+
 	log.Println("QuantumGuard v2 starting on port 3001...")
 	if err := http.ListenAndServe(":3001", nil); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
@@ -0,0 +1,30 @@
+package arf
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/your-org/quantumguard-v2/pkg/reasoners"
+)
+
+// Actuator dispatches a remediation action against the underlying platform.
+// Execute returns as soon as the action is accepted rather than blocking
+// until it completes — actions like scaling a deployment can take minutes.
+// Callers track completion via Status, normally through a Confirmer.
+type Actuator struct{}
+
+// Execute hands action off to the platform and returns a handle for it.
+func (a *Actuator) Execute(ctx context.Context, action reasoners.Action) (ActionHandle, error) {
+	// TODO: dispatch to the real platform API; stub accepts immediately.
+	return ActionHandle{
+		ID:    fmt.Sprintf("act-%d", time.Now().UnixNano()),
+		State: ActionPending,
+	}, nil
+}
+
+// Status reports the current state of a previously dispatched action.
+func (a *Actuator) Status(ctx context.Context, id string) (ActionState, error) {
+	// TODO: poll the real platform API; stub reports immediate success.
+	return ActionSucceeded, nil
+}
@@ -2,7 +2,12 @@ package arf
 
 import (
 	"context"
+	"log"
+
+	"github.com/your-org/quantumguard-v2/pkg/compliance"
+	"github.com/your-org/quantumguard-v2/pkg/events"
 	"github.com/your-org/quantumguard-v2/pkg/finops"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
 	"github.com/your-org/quantumguard-v2/pkg/telemetry"
 )
 
@@ -10,8 +15,33 @@ type AutonomicReasoningFabric struct {
 	AutoRemediation *AutoRemediation
 }
 
-func MustBuild(ctx context.Context, cost *finops.Tagger, tracer *telemetry.Tracer) *AutonomicReasoningFabric {
-	return &AutonomicReasoningFabric{
-		AutoRemediation: &AutoRemediation{},
+// Close drains AutoRemediation's in-flight Confirmer goroutines. See
+// AutoRemediation.Close.
+func (f *AutonomicReasoningFabric) Close() {
+	f.AutoRemediation.Close()
+}
+
+func MustBuild(ctx context.Context, cost *finops.Tagger, tracer *telemetry.Tracer, m *metrics.Metrics, store ActionStore, sink events.Sink, gate *compliance.Engine) *AutonomicReasoningFabric {
+	shutdown, cancel := context.WithCancel(ctx)
+	remediation := &AutoRemediation{
+		cost:           cost,
+		compliance:     gate,
+		metrics:        m,
+		tracer:         tracer,
+		store:          store,
+		events:         sink,
+		shutdown:       shutdown,
+		cancelShutdown: cancel,
 	}
-}
\ No newline at end of file
+	remediation.confirmer = NewConfirmer(&remediation.Actuate, store, cost, m, tracer)
+
+	// Re-spawn Watch for anything still pending from a prior process, so a
+	// restart mid-remediation (the store's whole reason for existing) doesn't
+	// orphan those actions. Best-effort: a store that can't list pending
+	// actions yet still serves new dispatches fine, so this only logs.
+	if err := remediation.resumePending(ctx); err != nil {
+		log.Printf("arf: resume pending actions: %v", err)
+	}
+
+	return &AutonomicReasoningFabric{AutoRemediation: remediation}
+}
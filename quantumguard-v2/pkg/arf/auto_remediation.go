@@ -2,41 +2,176 @@ package arf
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/your-org/quantumguard-v2/pkg/reasoners"
 	"github.com/your-org/quantumguard-v2/pkg/finops"
 	"github.com/your-org/quantumguard-v2/pkg/compliance"
+	"github.com/your-org/quantumguard-v2/pkg/events"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
+	"github.com/your-org/quantumguard-v2/pkg/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// decisionOutcomeKey, costUSDKey and actionIDKey are span-only attributes
+// specific to the auto_remediation/confirm spans, so they live next to the
+// code that sets them instead of metrics.attributes.go.
+const (
+	decisionOutcomeKey = attribute.Key("arf.decision_outcome")
+	costUSDKey         = attribute.Key("arf.cost_usd")
+	actionIDKey        = attribute.Key("arf.action_id")
 )
 
 // AutoRemediation plugs into The-Lap-Verse-Monitoring anomaly loop
 type AutoRemediation struct {
-	reasoners reasoners.Pool
-	cost      *finops.Tagger
+	reasoners  reasoners.Pool
+	cost       *finops.Tagger
 	compliance *compliance.Engine
-	Actuate   Actuator
+	metrics    *metrics.Metrics
+	tracer     *telemetry.Tracer
+	store      ActionStore
+	confirmer  *Confirmer
+	events     events.Sink
+	Actuate    Actuator
+
+	// ResumeCallback is invoked by the background Confirmer once a
+	// dispatched action reaches a terminal state.
+	ResumeCallback ResumeCallback
+
+	// shutdown/cancelShutdown and wg let Close drain in-flight Confirmer
+	// goroutines instead of leaking them past process lifetime: each Watch
+	// call runs under shutdown (cancelled by Close, not by the dispatching
+	// request's context) and is tracked in wg.
+	shutdown       context.Context
+	cancelShutdown context.CancelFunc
+	wg             sync.WaitGroup
+}
+
+// Close cancels any in-flight Confirmer polls and waits for their
+// goroutines to exit. Call it during graceful shutdown so a dispatched
+// action's background confirmation doesn't outlive the process silently.
+func (a *AutoRemediation) Close() {
+	a.cancelShutdown()
+	a.wg.Wait()
+}
+
+// ActionState looks up a previously dispatched remediation action by ID.
+func (a *AutoRemediation) ActionState(ctx context.Context, id string) (ActionRecord, error) {
+	return a.store.Get(ctx, id)
 }
 
-type Actuator struct {}
+// resumePending re-spawns a Confirmer.Watch for every action still
+// ActionPending in the store, so a process restart doesn't orphan actions a
+// prior instance dispatched but never saw through to completion (the
+// PostgresActionStore case). The rehydrated watch doesn't know the original
+// reasoner chain, so it reports "auto-remediation" like every dispatch
+// today does; this stops mattering once a reasoner can be something else.
+func (a *AutoRemediation) resumePending(ctx context.Context) error {
+	pending, err := a.store.ListPending(ctx)
+	if err != nil {
+		return fmt.Errorf("arf: list pending actions: %w", err)
+	}
 
-func (a *Actuator) Execute(ctx context.Context, action reasoners.Action) error {
-    return nil
+	for _, record := range pending {
+		handle := ActionHandle{ID: record.ID, State: record.State}
+		event := finops.ReasoningEvent{
+			Reasoner: "auto-remediation",
+			Cost:     record.Cost,
+			TenantID: record.TenantID,
+		}
+		a.wg.Add(1)
+		go func() {
+			defer a.wg.Done()
+			a.confirmer.Watch(a.shutdown, handle, event, a.ResumeCallback)
+		}()
+	}
+	return nil
 }
 
-func (a *AutoRemediation) Execute(ctx context.Context, metrics reasoners.SystemMetrics) error {
-	if metrics.ErrorRate < 0.12 { return nil } // skip noise
+func (a *AutoRemediation) Execute(ctx context.Context, sm reasoners.SystemMetrics) error {
+	ctx, span := a.tracer.Start(ctx, "arf.auto_remediation")
+	defer span.End()
+	span.SetAttributes(
+		metrics.ErrorRateKey.Float64(sm.ErrorRate),
+		metrics.TenantIDKey.String(sm.TenantID),
+	)
+
+	if sm.ErrorRate < 0.12 { // skip noise
+		span.SetAttributes(decisionOutcomeKey.String("skipped"))
+		return nil
+	}
 
 	// 1. Predict → Causal → Generate → Validate
-	action := a.reasoners.Solve(ctx, metrics)
+	reasonCtx, reasonSpan := a.tracer.Start(ctx, "arf.reason")
+	action := a.reasoners.Solve(reasonCtx, sm)
+	reasonSpan.End()
 
-	// 2. Compliance gate (OPA)
-	if err := a.compliance.ValidateAction(ctx, action); err != nil { return err }
+	const estimatedCost = 0.01
 
-	// 3. FinOps tag & bill
-	a.cost.EmitUsage(ctx, finops.ReasoningEvent{
-		Reasoner: "auto-remediation",
-		Cost:     0.01,
-		TenantID: metrics.TenantID,
+	// 2. Compliance gate (OPA)
+	complianceCtx, complianceSpan := a.tracer.Start(ctx, "arf.compliance")
+	err := a.compliance.ValidateAction(complianceCtx, compliance.Input{
+		Action:   action,
+		TenantID: sm.TenantID,
+		Cost:     estimatedCost,
+		Metrics:  sm,
 	})
+	complianceSpan.End()
+	if err != nil {
+		span.SetAttributes(decisionOutcomeKey.String("denied"))
+		return err
+	}
+
+	// 3. Dispatch via platform actuators. Execute returns as soon as the
+	// platform accepts the action; the Confirmer tracks it to completion and
+	// bills FinOps only once it actually lands.
+	actuateCtx, actuateSpan := a.tracer.Start(ctx, "arf.actuate")
+	handle, err := a.Actuate.Execute(actuateCtx, action)
+	actuateSpan.End()
+	if err != nil {
+		span.SetAttributes(decisionOutcomeKey.String("failed"))
+		return err
+	}
 
-	// 4. Execute via platform actuators
-	return a.Actuate.Execute(ctx, action)
-}
\ No newline at end of file
+	if err := a.store.Save(ctx, ActionRecord{
+		ID:       handle.ID,
+		TenantID: sm.TenantID,
+		Cost:     estimatedCost,
+		State:    handle.State,
+	}); err != nil {
+		return err
+	}
+	span.SetAttributes(decisionOutcomeKey.String("dispatched"), actionIDKey.String(handle.ID))
+
+	if a.events != nil {
+		remediationEvent := events.RemediationEvent{
+			Source:  "quantumguard-v2/arf",
+			Type:    "com.quantumguard.remediation.dispatched",
+			Time:    time.Now(),
+			Subject: handle.ID,
+			Data: events.RemediationData{
+				ReasonerChain:     []string{"auto-remediation"},
+				Action:            action,
+				EstimatedCostUSD:  estimatedCost,
+				ComplianceVerdict: "allowed",
+			},
+		}.Default()
+		if err := a.events.Emit(ctx, remediationEvent); err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.confirmer.Watch(a.shutdown, handle, finops.ReasoningEvent{
+			Reasoner: "auto-remediation",
+			Cost:     estimatedCost,
+			TenantID: sm.TenantID,
+		}, a.ResumeCallback)
+	}()
+
+	return nil
+}
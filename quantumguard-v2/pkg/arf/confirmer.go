@@ -0,0 +1,125 @@
+package arf
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/your-org/quantumguard-v2/pkg/finops"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
+	"github.com/your-org/quantumguard-v2/pkg/telemetry"
+)
+
+// ResumeCallback is invoked once a dispatched action reaches a terminal
+// state, so callers can react to remediation outcomes without polling
+// ActionStore themselves.
+type ResumeCallback func(ctx context.Context, handleID string, state ActionState, err error) error
+
+// Bounds on the Confirmer's exponential backoff between actuator status
+// checks, and how many consecutive transient Status errors it tolerates
+// (with that same backoff) before giving up on an action.
+const (
+	pollInitialBackoff  = 500 * time.Millisecond
+	pollMaxBackoff      = 30 * time.Second
+	pollMaxStatusErrors = 5
+)
+
+// statusChecker is the slice of Actuator that Confirmer depends on, broken
+// out so tests can drive Watch's retry/backoff logic against a fake
+// platform backend instead of the real Actuator.
+type statusChecker interface {
+	Status(ctx context.Context, id string) (ActionState, error)
+}
+
+// Confirmer polls an Actuator for the terminal state of dispatched actions,
+// persists that state, and only then bills FinOps and records remediation
+// metrics — so tenants aren't charged for actions that never landed.
+type Confirmer struct {
+	actuator       statusChecker
+	store          ActionStore
+	cost           *finops.Tagger
+	metrics        *metrics.Metrics
+	tracer         *telemetry.Tracer
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// NewConfirmer builds a Confirmer that polls actuator and persists to store.
+func NewConfirmer(actuator *Actuator, store ActionStore, cost *finops.Tagger, m *metrics.Metrics, tracer *telemetry.Tracer) *Confirmer {
+	return &Confirmer{
+		actuator:       actuator,
+		store:          store,
+		cost:           cost,
+		metrics:        m,
+		tracer:         tracer,
+		initialBackoff: pollInitialBackoff,
+		maxBackoff:     pollMaxBackoff,
+	}
+}
+
+// Watch polls handle to a terminal state with exponential backoff, persists
+// it, emits the deferred FinOps event on success, and invokes resume (if
+// set) with the outcome. It is meant to run in its own goroutine, detached
+// from the request that dispatched the action.
+//
+// A transient Status error doesn't end the watch: it's retried with the
+// same backoff as a pending poll, up to pollMaxStatusErrors in a row. Giving
+// up still persists a terminal ActionFailed so the store never keeps
+// reporting "pending" for an action the Confirmer has stopped watching.
+func (c *Confirmer) Watch(ctx context.Context, handle ActionHandle, event finops.ReasoningEvent, resume ResumeCallback) {
+	ctx, span := c.tracer.Start(ctx, "arf.confirm")
+	defer span.End()
+	span.SetAttributes(metrics.TenantIDKey.String(event.TenantID))
+
+	backoff := c.initialBackoff
+	state := handle.State
+	var statusErr error
+	consecutiveErrors := 0
+	for state == ActionPending {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		var polled ActionState
+		polled, statusErr = c.actuator.Status(ctx, handle.ID)
+		if statusErr != nil {
+			consecutiveErrors++
+			if consecutiveErrors >= pollMaxStatusErrors {
+				span.RecordError(statusErr)
+				state = ActionFailed
+				break
+			}
+		} else {
+			consecutiveErrors = 0
+			statusErr = nil
+			state = polled
+		}
+
+		backoff *= 2
+		if backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+
+	if err := c.store.UpdateState(ctx, handle.ID, state); err != nil {
+		statusErr = errors.Join(statusErr, err)
+	}
+
+	switch state {
+	case ActionSucceeded:
+		c.cost.EmitUsage(ctx, event)
+		c.metrics.RemediationCost.Add(ctx, event.Cost)
+		c.metrics.RemediationSuccess.Add(ctx, 1)
+	case ActionFailed:
+		c.metrics.RemediationFailure.Add(ctx, 1)
+	}
+	span.SetAttributes(decisionOutcomeKey.String(string(state)), costUSDKey.Float64(event.Cost))
+
+	if resume != nil {
+		if err := resume(ctx, handle.ID, state, statusErr); err != nil {
+			span.RecordError(err)
+		}
+	}
+}
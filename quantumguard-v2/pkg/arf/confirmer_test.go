@@ -0,0 +1,100 @@
+package arf
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/your-org/quantumguard-v2/pkg/finops"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
+	"github.com/your-org/quantumguard-v2/pkg/telemetry"
+)
+
+// flakyActuator fails Status a fixed number of times before reporting a
+// terminal state, so tests can drive Watch's error-retry path without a
+// real platform backend.
+type flakyActuator struct {
+	failures int
+	calls    int
+	state    ActionState
+}
+
+func (f *flakyActuator) Status(ctx context.Context, id string) (ActionState, error) {
+	f.calls++
+	if f.calls <= f.failures {
+		return "", errors.New("actuator: transient timeout")
+	}
+	return f.state, nil
+}
+
+func newTestConfirmer(actuator statusChecker) (*Confirmer, *InMemoryActionStore) {
+	store := NewInMemoryActionStore()
+	c := &Confirmer{
+		actuator:       actuator,
+		store:          store,
+		cost:           finops.MustTagger(context.Background(), "test"),
+		metrics:        metrics.NewNoop(),
+		tracer:         telemetry.MustTracer(context.Background(), "test"),
+		initialBackoff: time.Millisecond,
+		maxBackoff:     2 * time.Millisecond,
+	}
+	return c, store
+}
+
+func TestConfirmer_Watch_RetriesTransientErrors(t *testing.T) {
+	actuator := &flakyActuator{failures: 2, state: ActionSucceeded}
+	c, store := newTestConfirmer(actuator)
+
+	handle := ActionHandle{ID: "act-1", State: ActionPending}
+	if err := store.Save(context.Background(), ActionRecord{ID: handle.ID, State: ActionPending}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	c.Watch(context.Background(), handle, finops.ReasoningEvent{}, nil)
+
+	record, err := store.Get(context.Background(), handle.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if record.State != ActionSucceeded {
+		t.Errorf("State = %q, want %q", record.State, ActionSucceeded)
+	}
+	if actuator.calls != 3 {
+		t.Errorf("Status called %d times, want 3 (2 failures + 1 success)", actuator.calls)
+	}
+}
+
+func TestConfirmer_Watch_GivesUpAfterMaxStatusErrors(t *testing.T) {
+	actuator := &flakyActuator{failures: pollMaxStatusErrors + 5, state: ActionSucceeded}
+	c, store := newTestConfirmer(actuator)
+
+	handle := ActionHandle{ID: "act-2", State: ActionPending}
+	if err := store.Save(context.Background(), ActionRecord{ID: handle.ID, State: ActionPending}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	var resumeState ActionState
+	var resumeErr error
+	c.Watch(context.Background(), handle, finops.ReasoningEvent{}, func(ctx context.Context, id string, state ActionState, err error) error {
+		resumeState, resumeErr = state, err
+		return nil
+	})
+
+	record, err := store.Get(context.Background(), handle.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if record.State != ActionFailed {
+		t.Errorf("persisted State = %q, want %q", record.State, ActionFailed)
+	}
+	if resumeState != ActionFailed {
+		t.Errorf("resume state = %q, want %q", resumeState, ActionFailed)
+	}
+	if resumeErr == nil {
+		t.Error("resume err = nil, want the transient Status error")
+	}
+	if actuator.calls != pollMaxStatusErrors {
+		t.Errorf("Status called %d times, want %d (gives up at the limit)", actuator.calls, pollMaxStatusErrors)
+	}
+}
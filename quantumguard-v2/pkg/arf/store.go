@@ -0,0 +1,98 @@
+package arf
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ActionState is the lifecycle of a dispatched remediation action.
+type ActionState string
+
+const (
+	ActionPending   ActionState = "pending"
+	ActionSucceeded ActionState = "succeeded"
+	ActionFailed    ActionState = "failed"
+)
+
+// ActionHandle identifies an in-flight remediation action so callers can
+// track it to completion without blocking on Actuator.Execute.
+type ActionHandle struct {
+	ID    string
+	State ActionState
+}
+
+// ActionRecord is what an ActionStore persists for a dispatched action.
+type ActionRecord struct {
+	ID       string
+	TenantID string
+	Cost     float64
+	State    ActionState
+}
+
+// ErrActionNotFound is returned by ActionStore.Get/UpdateState when no
+// record exists for the given ID.
+var ErrActionNotFound = errors.New("arf: action not found")
+
+// ActionStore persists dispatched remediation actions. A ListPending result
+// is only actionable if something re-spawns Watch for those rows at
+// startup; see arf.MustBuild, which does so for both implementations below.
+type ActionStore interface {
+	Save(ctx context.Context, record ActionRecord) error
+	Get(ctx context.Context, id string) (ActionRecord, error)
+	UpdateState(ctx context.Context, id string, state ActionState) error
+	ListPending(ctx context.Context) ([]ActionRecord, error)
+}
+
+// InMemoryActionStore is an ActionStore for tests and single-process
+// deployments. Pending actions don't survive a restart.
+type InMemoryActionStore struct {
+	mu      sync.Mutex
+	records map[string]ActionRecord
+}
+
+// NewInMemoryActionStore builds an empty InMemoryActionStore.
+func NewInMemoryActionStore() *InMemoryActionStore {
+	return &InMemoryActionStore{records: map[string]ActionRecord{}}
+}
+
+func (s *InMemoryActionStore) Save(ctx context.Context, record ActionRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[record.ID] = record
+	return nil
+}
+
+func (s *InMemoryActionStore) Get(ctx context.Context, id string) (ActionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[id]
+	if !ok {
+		return ActionRecord{}, ErrActionNotFound
+	}
+	return record, nil
+}
+
+func (s *InMemoryActionStore) UpdateState(ctx context.Context, id string, state ActionState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	record, ok := s.records[id]
+	if !ok {
+		return ErrActionNotFound
+	}
+	record.State = state
+	s.records[id] = record
+	return nil
+}
+
+func (s *InMemoryActionStore) ListPending(ctx context.Context) ([]ActionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := make([]ActionRecord, 0)
+	for _, record := range s.records {
+		if record.State == ActionPending {
+			pending = append(pending, record)
+		}
+	}
+	return pending, nil
+}
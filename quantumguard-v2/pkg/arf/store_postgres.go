@@ -0,0 +1,80 @@
+package arf
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// PostgresActionStore persists pending actions in Postgres so a Confirmer
+// survives process restarts without losing track of in-flight work. The
+// caller owns db and the `arf_actions(id text primary key, tenant_id text,
+// cost_usd double precision, state text)` table/driver it points at.
+type PostgresActionStore struct {
+	db *sql.DB
+}
+
+// NewPostgresActionStore wraps an existing *sql.DB as an ActionStore.
+func NewPostgresActionStore(db *sql.DB) *PostgresActionStore {
+	return &PostgresActionStore{db: db}
+}
+
+func (s *PostgresActionStore) Save(ctx context.Context, record ActionRecord) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO arf_actions (id, tenant_id, cost_usd, state)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET state = EXCLUDED.state`,
+		record.ID, record.TenantID, record.Cost, record.State)
+	if err != nil {
+		return fmt.Errorf("arf: save action %s: %w", record.ID, err)
+	}
+	return nil
+}
+
+func (s *PostgresActionStore) Get(ctx context.Context, id string) (ActionRecord, error) {
+	var record ActionRecord
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id, tenant_id, cost_usd, state FROM arf_actions WHERE id = $1`, id,
+	).Scan(&record.ID, &record.TenantID, &record.Cost, &record.State)
+	if err == sql.ErrNoRows {
+		return ActionRecord{}, ErrActionNotFound
+	}
+	if err != nil {
+		return ActionRecord{}, fmt.Errorf("arf: get action %s: %w", id, err)
+	}
+	return record, nil
+}
+
+func (s *PostgresActionStore) UpdateState(ctx context.Context, id string, state ActionState) error {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE arf_actions SET state = $1 WHERE id = $2`, state, id)
+	if err != nil {
+		return fmt.Errorf("arf: update action %s: %w", id, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrActionNotFound
+	}
+	return nil
+}
+
+func (s *PostgresActionStore) ListPending(ctx context.Context) ([]ActionRecord, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, tenant_id, cost_usd, state FROM arf_actions WHERE state = $1`, ActionPending)
+	if err != nil {
+		return nil, fmt.Errorf("arf: list pending actions: %w", err)
+	}
+	defer rows.Close()
+
+	pending := make([]ActionRecord, 0)
+	for rows.Next() {
+		var record ActionRecord
+		if err := rows.Scan(&record.ID, &record.TenantID, &record.Cost, &record.State); err != nil {
+			return nil, fmt.Errorf("arf: scan pending action: %w", err)
+		}
+		pending = append(pending, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("arf: list pending actions: %w", err)
+	}
+	return pending, nil
+}
@@ -0,0 +1,35 @@
+package arf
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInMemoryActionStore_ListPending(t *testing.T) {
+	store := NewInMemoryActionStore()
+	ctx := context.Background()
+
+	records := []ActionRecord{
+		{ID: "act-1", TenantID: "acme", Cost: 0.01, State: ActionPending},
+		{ID: "act-2", TenantID: "acme", Cost: 0.02, State: ActionSucceeded},
+		{ID: "act-3", TenantID: "globex", Cost: 0.03, State: ActionPending},
+	}
+	for _, record := range records {
+		if err := store.Save(ctx, record); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	pending, err := store.ListPending(ctx)
+	if err != nil {
+		t.Fatalf("ListPending() error = %v", err)
+	}
+
+	got := map[string]bool{}
+	for _, record := range pending {
+		got[record.ID] = true
+	}
+	if len(got) != 2 || !got["act-1"] || !got["act-3"] {
+		t.Errorf("ListPending() = %+v, want act-1 and act-3 only", pending)
+	}
+}
@@ -0,0 +1,41 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/open-policy-agent/opa/bundle"
+)
+
+func loadBundle(ctx context.Context, cfg Config) (*bundle.Bundle, error) {
+	if cfg.BundleServerURL != "" {
+		return fetchBundle(ctx, cfg.BundleServerURL)
+	}
+	b, err := bundle.NewCustomReader(bundle.NewDirectoryLoader(cfg.BundlePath)).Read()
+	if err != nil {
+		return nil, fmt.Errorf("compliance: read bundle dir %s: %w", cfg.BundlePath, err)
+	}
+	return &b, nil
+}
+
+func fetchBundle(ctx context.Context, url string) (*bundle.Bundle, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: fetch bundle from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("compliance: bundle server %s returned %s", url, resp.Status)
+	}
+
+	b, err := bundle.NewReader(resp.Body).Read()
+	if err != nil {
+		return nil, fmt.Errorf("compliance: decode bundle from %s: %w", url, err)
+	}
+	return &b, nil
+}
@@ -0,0 +1,24 @@
+package compliance
+
+import "os"
+
+// Config controls where Engine loads its policy bundle from.
+type Config struct {
+	// BundlePath is a local bundle directory. Ignored when BundleServerURL
+	// is set.
+	BundlePath string
+	// BundleServerURL, if set, is fetched as a gzipped tarball bundle
+	// instead of reading BundlePath from local disk.
+	BundleServerURL string
+}
+
+// ConfigFromEnv reads the bundle location from the environment:
+//
+//	QUANTUMGUARD_POLICY_BUNDLE_PATH   local bundle directory
+//	QUANTUMGUARD_POLICY_BUNDLE_URL    HTTP bundle server URL (takes priority)
+func ConfigFromEnv() Config {
+	return Config{
+		BundlePath:      os.Getenv("QUANTUMGUARD_POLICY_BUNDLE_PATH"),
+		BundleServerURL: os.Getenv("QUANTUMGUARD_POLICY_BUNDLE_URL"),
+	}
+}
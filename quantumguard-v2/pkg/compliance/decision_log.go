@@ -0,0 +1,37 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Decision is one allow/deny verdict the DecisionLogger records, input and
+// result together so auditors can reconstruct why an action was let through
+// or blocked without re-running the policy.
+type Decision struct {
+	Time    time.Time `json:"time"`
+	Input   Input     `json:"input"`
+	Allowed bool      `json:"allowed"`
+}
+
+// DecisionLogger writes every compliance decision to an audit sink,
+// backing the "action auto-certified" claim the HTTP handler makes.
+type DecisionLogger struct {
+	Writer io.Writer
+}
+
+// NewDecisionLogger builds a DecisionLogger writing to w.
+func NewDecisionLogger(w io.Writer) *DecisionLogger {
+	return &DecisionLogger{Writer: w}
+}
+
+// Log records one decision as a JSON line.
+func (l *DecisionLogger) Log(ctx context.Context, input Input, allowed bool) {
+	_ = json.NewEncoder(l.Writer).Encode(Decision{
+		Time:    time.Now(),
+		Input:   input,
+		Allowed: allowed,
+	})
+}
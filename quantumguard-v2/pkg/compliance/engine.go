@@ -0,0 +1,156 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync/atomic"
+
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/your-org/quantumguard-v2/pkg/reasoners"
+)
+
+// allowQuery is the Rego rule every policy bundle must define.
+const allowQuery = "data.quantumguard.remediation.allow"
+
+// Input is the document evaluated against allowQuery.
+type Input struct {
+	Action   reasoners.Action        `json:"action"`
+	TenantID string                  `json:"tenant_id"`
+	Cost     float64                 `json:"cost_usd"`
+	Metrics  reasoners.SystemMetrics `json:"metrics"`
+}
+
+// DenyReason describes why a policy rejected an action.
+type DenyReason struct {
+	Policy  string
+	Rule    string
+	Message string
+}
+
+func (d *DenyReason) Error() string {
+	return fmt.Sprintf("compliance: denied by %s/%s: %s", d.Policy, d.Rule, d.Message)
+}
+
+// Policy describes one package loaded from the active bundle.
+type Policy struct {
+	Package  string
+	Revision string
+}
+
+// loadedBundle is the prepared query plus the metadata Policies() reports,
+// swapped atomically on Reload so in-flight evaluations never see a
+// half-loaded bundle.
+type loadedBundle struct {
+	query    rego.PreparedEvalQuery
+	revision string
+	packages []string
+}
+
+// Engine evaluates remediation actions against Rego policy bundles loaded
+// from disk or an HTTP bundle server, gating AutoRemediation.Execute's
+// compliance stage with a real OPA decision instead of a no-op.
+type Engine struct {
+	cfg         Config
+	decisionLog *DecisionLogger
+	loaded      atomic.Pointer[loadedBundle]
+}
+
+// New builds an Engine, performing its first bundle load synchronously so
+// callers find out immediately if a *configured* bundle is missing or
+// invalid. A Config with neither BundlePath nor BundleServerURL set (the
+// default on a fresh checkout or dev box) is not an error: New returns an
+// unconfigured Engine that fails closed per-action in ValidateAction instead
+// of making the whole process unrunnable, and logs once so the gap isn't
+// silent.
+func New(ctx context.Context, cfg Config, decisionLog *DecisionLogger) (*Engine, error) {
+	e := &Engine{cfg: cfg, decisionLog: decisionLog}
+	if cfg.BundlePath == "" && cfg.BundleServerURL == "" {
+		log.Printf("compliance: no policy bundle configured (set QUANTUMGUARD_POLICY_BUNDLE_PATH or QUANTUMGUARD_POLICY_BUNDLE_URL); every remediation will be denied until one is loaded")
+		return e, nil
+	}
+	if err := e.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Reload re-loads the configured bundle and atomically swaps the prepared
+// query, so a SIGHUP (see WatchReload) picks up policy changes without
+// restarting the process.
+func (e *Engine) Reload(ctx context.Context) error {
+	b, err := loadBundle(ctx, e.cfg)
+	if err != nil {
+		return err
+	}
+
+	prepared, err := rego.New(
+		rego.Query(allowQuery),
+		rego.ParsedBundle("quantumguard", b),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return fmt.Errorf("compliance: prepare query: %w", err)
+	}
+
+	packages := make([]string, 0, len(b.Modules))
+	for _, m := range b.Modules {
+		packages = append(packages, m.Parsed.Package.Path.String())
+	}
+
+	e.loaded.Store(&loadedBundle{
+		query:    prepared,
+		revision: b.Manifest.Revision,
+		packages: packages,
+	})
+	return nil
+}
+
+// ValidateAction evaluates input against the loaded policy bundle,
+// returning a *DenyReason when the policy rejects it. An Engine with no
+// bundle loaded (see New) fails closed rather than evaluating anything.
+func (e *Engine) ValidateAction(ctx context.Context, input Input) error {
+	loaded := e.loaded.Load()
+	if loaded == nil {
+		if e.decisionLog != nil {
+			e.decisionLog.Log(ctx, input, false)
+		}
+		return &DenyReason{
+			Policy:  "quantumguard.remediation",
+			Rule:    "bundle",
+			Message: "no policy bundle configured",
+		}
+	}
+
+	results, err := loaded.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return fmt.Errorf("compliance: evaluate: %w", err)
+	}
+	allowed := len(results) > 0 && len(results[0].Expressions) > 0 && results[0].Expressions[0].Value == true
+
+	if e.decisionLog != nil {
+		e.decisionLog.Log(ctx, input, allowed)
+	}
+	if allowed {
+		return nil
+	}
+
+	return &DenyReason{
+		Policy:  "quantumguard.remediation",
+		Rule:    "allow",
+		Message: "action denied by policy",
+	}
+}
+
+// Policies lists every package loaded from the active bundle, for the
+// /api/v2/policies route. It returns nil when no bundle is loaded.
+func (e *Engine) Policies() []Policy {
+	loaded := e.loaded.Load()
+	if loaded == nil {
+		return nil
+	}
+	policies := make([]Policy, 0, len(loaded.packages))
+	for _, pkg := range loaded.packages {
+		policies = append(policies, Policy{Package: pkg, Revision: loaded.revision})
+	}
+	return policies
+}
@@ -0,0 +1,104 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// testPolicy is an inline Rego fixture so ValidateAction can be exercised
+// without standing up an actual bundle on disk or an HTTP bundle server.
+const testPolicy = `
+package quantumguard.remediation
+
+default allow = false
+
+allow {
+	input.cost_usd < 1
+	input.tenant_id != "blocked-tenant"
+}
+`
+
+func newTestEngine(t *testing.T) *Engine {
+	t.Helper()
+	prepared, err := rego.New(
+		rego.Query(allowQuery),
+		rego.Module("policy.rego", testPolicy),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		t.Fatalf("prepare test policy: %v", err)
+	}
+
+	e := &Engine{}
+	e.loaded.Store(&loadedBundle{
+		query:    prepared,
+		revision: "test",
+		packages: []string{"quantumguard.remediation"},
+	})
+	return e
+}
+
+func TestEngine_ValidateAction(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      Input
+		wantDenied bool
+	}{
+		{
+			name:       "cheap action for an allowed tenant is allowed",
+			input:      Input{TenantID: "acme", Cost: 0.01},
+			wantDenied: false,
+		},
+		{
+			name:       "blocked tenant is denied",
+			input:      Input{TenantID: "blocked-tenant", Cost: 0.01},
+			wantDenied: true,
+		},
+		{
+			name:       "action over the cost ceiling is denied",
+			input:      Input{TenantID: "acme", Cost: 5},
+			wantDenied: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newTestEngine(t)
+			err := e.ValidateAction(context.Background(), tt.input)
+
+			var denyErr *DenyReason
+			isDenied := errors.As(err, &denyErr)
+			if isDenied != tt.wantDenied {
+				t.Fatalf("ValidateAction() error = %v, wantDenied %v", err, tt.wantDenied)
+			}
+		})
+	}
+}
+
+func TestEngine_ValidateAction_NoBundleConfigured(t *testing.T) {
+	e := &Engine{}
+	err := e.ValidateAction(context.Background(), Input{TenantID: "acme"})
+
+	var denyErr *DenyReason
+	if !errors.As(err, &denyErr) {
+		t.Fatalf("ValidateAction() error = %v, want *DenyReason", err)
+	}
+	if denyErr.Rule != "bundle" {
+		t.Errorf("DenyReason.Rule = %q, want %q", denyErr.Rule, "bundle")
+	}
+}
+
+func TestEngine_Policies(t *testing.T) {
+	e := newTestEngine(t)
+	got := e.Policies()
+	if len(got) != 1 || got[0].Package != "quantumguard.remediation" || got[0].Revision != "test" {
+		t.Errorf("Policies() = %+v, want one quantumguard.remediation@test", got)
+	}
+
+	empty := (&Engine{}).Policies()
+	if empty != nil {
+		t.Errorf("Policies() on an unconfigured Engine = %+v, want nil", empty)
+	}
+}
@@ -0,0 +1,28 @@
+package compliance
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// WatchReload re-loads the bundle on SIGHUP until ctx is done. Run it in
+// its own goroutine.
+func (e *Engine) WatchReload(ctx context.Context) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigCh:
+			if err := e.Reload(ctx); err != nil {
+				log.Printf("compliance: bundle reload failed: %v", err)
+			}
+		}
+	}
+}
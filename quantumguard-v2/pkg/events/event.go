@@ -0,0 +1,55 @@
+package events
+
+import (
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/your-org/quantumguard-v2/pkg/reasoners"
+)
+
+// SpecVersion is the CloudEvents spec version RemediationEvent implements.
+const SpecVersion = "1.0"
+
+// RemediationEvent is QuantumGuard's CloudEvents-shaped description of a
+// single auto-remediation, so downstream systems (SIEM, audit log, billing)
+// can consume remediation output as a structured event instead of reaching
+// into our internal types.
+type RemediationEvent struct {
+	SpecVersion string
+	ID          string
+	Source      string
+	Type        string
+	Time        time.Time
+	Subject     string
+	// Reference names the workload the remediation targeted. It is nil when
+	// the reasoner couldn't attribute the action to a specific workload.
+	Reference *ObjectRef
+	Data      RemediationData
+}
+
+// ObjectRef names a Kubernetes-style workload (kind/namespace/name).
+type ObjectRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// RemediationData is the CloudEvents "data" payload for a RemediationEvent.
+type RemediationData struct {
+	ReasonerChain     []string
+	Action            reasoners.Action
+	EstimatedCostUSD  float64
+	ComplianceVerdict string
+}
+
+// Default fills in the fields every RemediationEvent needs regardless of
+// caller input — SpecVersion and a time-sortable ID if one wasn't already
+// set. It never touches Reference: a caller who left it nil gets nil back,
+// rather than Default inventing a zero-value ObjectRef.
+func (e RemediationEvent) Default() RemediationEvent {
+	e.SpecVersion = SpecVersion
+	if e.ID == "" {
+		e.ID = ulid.Make().String()
+	}
+	return e
+}
@@ -0,0 +1,55 @@
+package events
+
+import "testing"
+
+func TestRemediationEvent_Default(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            RemediationEvent
+		wantReference *ObjectRef
+	}{
+		{
+			name:          "explicit nil reference stays nil",
+			in:            RemediationEvent{},
+			wantReference: nil,
+		},
+		{
+			name: "caller-supplied reference is preserved",
+			in: RemediationEvent{
+				Reference: &ObjectRef{Kind: "Deployment", Namespace: "prod", Name: "auth-service"},
+			},
+			wantReference: &ObjectRef{Kind: "Deployment", Namespace: "prod", Name: "auth-service"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in.Default()
+
+			if got.SpecVersion != SpecVersion {
+				t.Errorf("SpecVersion = %q, want %q", got.SpecVersion, SpecVersion)
+			}
+			if got.ID == "" {
+				t.Error("ID = \"\", want a generated ULID")
+			}
+
+			switch {
+			case tt.wantReference == nil:
+				if got.Reference != nil {
+					t.Errorf("Reference = %+v, want nil", got.Reference)
+				}
+			case got.Reference == nil:
+				t.Errorf("Reference = nil, want %+v", tt.wantReference)
+			case *got.Reference != *tt.wantReference:
+				t.Errorf("Reference = %+v, want %+v", got.Reference, tt.wantReference)
+			}
+		})
+	}
+}
+
+func TestRemediationEvent_Default_PreservesExistingID(t *testing.T) {
+	event := RemediationEvent{ID: "existing-id"}.Default()
+	if event.ID != "existing-id" {
+		t.Errorf("ID = %q, want %q", event.ID, "existing-id")
+	}
+}
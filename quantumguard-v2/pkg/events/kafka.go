@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// KafkaSink publishes events as JSON to a Kafka topic, keyed by event
+// Subject rather than the per-event ID, so a partitioned topic routes every
+// event with the same Subject to the same partition and keeps their order.
+// AutoRemediation sets Subject to the action's handle ID, so that ordering
+// is per action, not per workload.
+type KafkaSink struct {
+	Writer *kafka.Writer
+}
+
+func (s *KafkaSink) Emit(ctx context.Context, event RemediationEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("events: marshal %s: %w", event.ID, err)
+	}
+	if err := s.Writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.Subject),
+		Value: payload,
+	}); err != nil {
+		return fmt.Errorf("events: write %s: %w", event.ID, err)
+	}
+	return nil
+}
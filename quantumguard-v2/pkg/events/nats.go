@@ -0,0 +1,26 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSSink publishes events as JSON to a NATS subject.
+type NATSSink struct {
+	Conn    *nats.Conn
+	Subject string
+}
+
+func (s *NATSSink) Emit(ctx context.Context, event RemediationEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("events: marshal %s: %w", event.ID, err)
+	}
+	if err := s.Conn.Publish(s.Subject, payload); err != nil {
+		return fmt.Errorf("events: publish %s to %s: %w", event.ID, s.Subject, err)
+	}
+	return nil
+}
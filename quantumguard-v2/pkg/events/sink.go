@@ -0,0 +1,9 @@
+package events
+
+import "context"
+
+// Sink publishes RemediationEvents to wherever downstream systems (SIEM,
+// audit log, billing) consume them.
+type Sink interface {
+	Emit(ctx context.Context, event RemediationEvent) error
+}
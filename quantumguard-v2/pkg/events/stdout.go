@@ -0,0 +1,21 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// StdoutSink writes each event as a JSON line to Writer. It's the simplest
+// Sink, useful for local development and as the zero-config default.
+type StdoutSink struct {
+	Writer io.Writer
+}
+
+func (s StdoutSink) Emit(ctx context.Context, event RemediationEvent) error {
+	if err := json.NewEncoder(s.Writer).Encode(event); err != nil {
+		return fmt.Errorf("events: encode %s: %w", event.ID, err)
+	}
+	return nil
+}
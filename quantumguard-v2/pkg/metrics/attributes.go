@@ -0,0 +1,11 @@
+package metrics
+
+import "go.opentelemetry.io/otel/attribute"
+
+// Attribute keys shared by metrics and tracing call sites so the same
+// remediation dimensions show up consistently whether they're read off a
+// counter or a span.
+const (
+	ErrorRateKey = attribute.Key("lapverse.error_rate")
+	TenantIDKey  = attribute.Key("lapverse.tenant_id")
+)
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Config controls how QuantumGuard exports metrics at startup.
+type Config struct {
+	// Endpoint is the OTLP/gRPC collector address, e.g. "otel-collector:4317".
+	// Leave empty to fall back to NoopMeterFactory.
+	Endpoint string
+	// Insecure disables TLS when dialing Endpoint.
+	Insecure bool
+	// ServiceName is recorded as the resource's service.name attribute.
+	ServiceName string
+	// ResourceAttributes are added to every exported metric's resource,
+	// e.g. {"deployment.environment": "prod"}.
+	ResourceAttributes map[string]string
+}
+
+func (c Config) resourceOptions() []resource.Option {
+	kvs := []attribute.KeyValue{semconv.ServiceName(c.ServiceName)}
+	for k, v := range c.ResourceAttributes {
+		kvs = append(kvs, attribute.String(k, v))
+	}
+	return []resource.Option{resource.WithAttributes(kvs...)}
+}
+
+// ConfigFromEnv reads OTLP endpoint and resource attributes from the
+// environment so quantumguard.MustBuild can wire up exporters without its
+// own flag parsing:
+//
+//	QUANTUMGUARD_METRICS_ENDPOINT         OTLP/gRPC collector address
+//	QUANTUMGUARD_METRICS_INSECURE         "true" to skip TLS
+//	QUANTUMGUARD_METRICS_RESOURCE_ATTRS   "key=value,key2=value2"
+func ConfigFromEnv(serviceName string) Config {
+	cfg := Config{
+		ServiceName: serviceName,
+		Endpoint:    os.Getenv("QUANTUMGUARD_METRICS_ENDPOINT"),
+	}
+	if insecure, err := strconv.ParseBool(os.Getenv("QUANTUMGUARD_METRICS_INSECURE")); err == nil {
+		cfg.Insecure = insecure
+	}
+	if raw := os.Getenv("QUANTUMGUARD_METRICS_RESOURCE_ATTRS"); raw != "" {
+		cfg.ResourceAttributes = map[string]string{}
+		for _, pair := range strings.Split(raw, ",") {
+			k, v, ok := strings.Cut(pair, "=")
+			if !ok {
+				continue
+			}
+			cfg.ResourceAttributes[k] = v
+		}
+	}
+	return cfg
+}
+
+// BuildFactory returns an OTLPMeterFactory when cfg.Endpoint is set, or
+// NoopMeterFactory otherwise. Callers get a working MeterFactory either way.
+func BuildFactory(ctx context.Context, cfg Config) (MeterFactory, error) {
+	if cfg.Endpoint == "" {
+		return NoopMeterFactory{}, nil
+	}
+	return NewOTLPMeterFactory(ctx, cfg)
+}
+
+// PrometheusHandler exposes the metrics factory has collected for scraping. It
+// returns nil for factories that don't export a Prometheus reader (e.g.
+// NoopMeterFactory), so callers should only mount it when non-nil.
+func PrometheusHandler(factory MeterFactory) http.Handler {
+	if _, ok := factory.(*OTLPMeterFactory); !ok {
+		return nil
+	}
+	return promhttp.Handler()
+}
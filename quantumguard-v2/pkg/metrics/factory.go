@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// MeterFactory abstracts the construction of an OTel meter so callers can
+// pick an exporter at startup instead of being stuck with whatever the
+// package wired in at init time.
+type MeterFactory interface {
+	Meter(name string) metric.Meter
+}
+
+// NoopMeterFactory discards everything recorded against it. It is the
+// zero-value default so QuantumGuard keeps working with no metrics backend
+// configured.
+type NoopMeterFactory struct{}
+
+// Meter implements MeterFactory.
+func (NoopMeterFactory) Meter(name string) metric.Meter {
+	return noop.NewMeterProvider().Meter(name)
+}
+
+// OTLPMeterFactory exports metrics via OTLP/gRPC and also exposes them for
+// Prometheus scraping, so operators get either pull or push without standing
+// up two pipelines.
+type OTLPMeterFactory struct {
+	provider *sdkmetric.MeterProvider
+	promExp  *prometheus.Exporter
+}
+
+// NewOTLPMeterFactory dials cfg.Endpoint and builds a meter provider that
+// periodically pushes via OTLP and registers a Prometheus reader for the
+// /metrics handler built in config.go.
+func NewOTLPMeterFactory(ctx context.Context, cfg Config) (*OTLPMeterFactory, error) {
+	otlpOpts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		otlpOpts = append(otlpOpts, otlpmetricgrpc.WithInsecure())
+	}
+	otlpExp, err := otlpmetricgrpc.New(ctx, otlpOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: build otlp exporter: %w", err)
+	}
+
+	promExp, err := prometheus.New()
+	if err != nil {
+		return nil, fmt.Errorf("metrics: build prometheus exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx, cfg.resourceOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: build resource: %w", err)
+	}
+
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(otlpExp)),
+		sdkmetric.WithReader(promExp),
+	)
+
+	return &OTLPMeterFactory{provider: provider, promExp: promExp}, nil
+}
+
+// Meter implements MeterFactory.
+func (f *OTLPMeterFactory) Meter(name string) metric.Meter {
+	return f.provider.Meter(name)
+}
+
+// Shutdown flushes pending metrics and releases exporter resources.
+func (f *OTLPMeterFactory) Shutdown(ctx context.Context) error {
+	return f.provider.Shutdown(ctx)
+}
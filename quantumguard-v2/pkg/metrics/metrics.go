@@ -1,16 +1,41 @@
 package metrics
 
-import (
-	"go.opentelemetry.io/otel/metric"
-	"go.opentelemetry.io/otel/metric/noop"
-)
+import "go.opentelemetry.io/otel/metric"
 
-var meterProvider = noop.NewMeterProvider()
-var meter = meterProvider.Meter("quantumguard-v2")
+// Metrics holds every instrument AutoRemediation emits. It is built once at
+// startup via New and threaded through the call chain instead of living on
+// package-level vars, so tests and alternate exporters each get their own
+// isolated set of counters.
+type Metrics struct {
+	RemediationCost    metric.Float64Counter
+	RemediationSuccess metric.Int64Counter
+	RemediationFailure metric.Int64Counter
+}
 
-var (
-	RemediationCost, _ = meter.Float64Counter("lapverse_remediation.cost_usd",
+// New builds a Metrics bundle backed by the given factory, registering the
+// instruments AutoRemediation records against.
+func New(factory MeterFactory) *Metrics {
+	if factory == nil {
+		factory = NoopMeterFactory{}
+	}
+	meter := factory.Meter("quantumguard-v2")
+
+	cost, _ := meter.Float64Counter("lapverse_remediation.cost_usd",
 		metric.WithDescription("Cost per auto-remediation action in USD"))
-	RemediationSuccess, _ = meter.Int64Counter("lapverse_remediation.success_total",
+	success, _ := meter.Int64Counter("lapverse_remediation.success_total",
 		metric.WithDescription("Successful auto-remediation actions"))
-)
\ No newline at end of file
+	failure, _ := meter.Int64Counter("lapverse_remediation.failure_total",
+		metric.WithDescription("Failed auto-remediation actions"))
+
+	return &Metrics{
+		RemediationCost:    cost,
+		RemediationSuccess: success,
+		RemediationFailure: failure,
+	}
+}
+
+// NewNoop builds a Metrics bundle backed by NoopMeterFactory, for tests and
+// callers that don't want to configure an exporter.
+func NewNoop() *Metrics {
+	return New(NoopMeterFactory{})
+}
@@ -2,18 +2,23 @@ package quantumguard
 
 import (
 	"context"
-	"net/http"
+	"fmt"
+	"os"
 
 	"github.com/your-org/quantumguard-v2/pkg/arf"
+	"github.com/your-org/quantumguard-v2/pkg/events"
 	"github.com/your-org/quantumguard-v2/pkg/finops"
 	"github.com/your-org/quantumguard-v2/pkg/reasoners"
 	"github.com/your-org/quantumguard-v2/pkg/compliance"
+	"github.com/your-org/quantumguard-v2/pkg/metrics"
 	"github.com/your-org/quantumguard-v2/pkg/telemetry"
 )
 
 // QuantumGuard plugs into The-Lap-Verse-Monitoring without breaking existing routes
 type QuantumGuard struct {
-	ARF *arf.AutonomicReasoningFabric
+	ARF          *arf.AutonomicReasoningFabric
+	MeterFactory metrics.MeterFactory
+	Compliance   *compliance.Engine
 }
 
 func MustBuild(ctx context.Context) *QuantumGuard {
@@ -22,12 +27,55 @@ func MustBuild(ctx context.Context) *QuantumGuard {
 	// Re-use existing telemetry tracer
 	tracer := telemetry.MustTracer(ctx, "quantumguard-v2")
 
-	arf := arf.MustBuild(ctx, cost, tracer)
+	// Pick the metrics exporter from the environment so operators can
+	// point it at a collector without touching code.
+	factory, err := metrics.BuildFactory(ctx, metrics.ConfigFromEnv("quantumguard-v2"))
+	if err != nil {
+		factory = metrics.NoopMeterFactory{}
+	}
+	m := metrics.New(factory)
 
-	return &QuantumGuard{ARF: arf}
+	// In-memory by default, so pending actions are lost on restart; swap in
+	// arf.NewPostgresActionStore(db) to survive one, since arf.MustBuild
+	// re-spawns Watch for anything ListPending still reports as pending.
+	store := arf.NewInMemoryActionStore()
+
+	// Stdout by default; swap in an events.NATSSink/KafkaSink to reach a
+	// real SIEM/audit/billing consumer.
+	sink := events.StdoutSink{Writer: os.Stdout}
+
+	decisionLog := compliance.NewDecisionLogger(os.Stdout)
+	gate, err := compliance.New(ctx, compliance.ConfigFromEnv(), decisionLog)
+	if err != nil {
+		panic(fmt.Sprintf("quantumguard: build compliance engine: %v", err))
+	}
+	go gate.WatchReload(ctx)
+
+	autoRemediationFabric := arf.MustBuild(ctx, cost, tracer, m, store, sink, gate)
+
+	return &QuantumGuard{ARF: autoRemediationFabric, MeterFactory: factory, Compliance: gate}
 }
 
 // HandleAnomaly plugs into your existing anomaly detection loop
-func (qg *QuantumGuard) HandleAnomaly(metrics reasoners.SystemMetrics) error {
-	return qg.ARF.AutoRemediation.Execute(context.Background(), metrics)
-}
\ No newline at end of file
+func (qg *QuantumGuard) HandleAnomaly(sm reasoners.SystemMetrics) error {
+	return qg.HandleAnomalyCtx(context.Background(), sm)
+}
+
+// HandleAnomalyCtx is the context-aware variant of HandleAnomaly, for
+// monitoring loops that already carry a trace context and want the
+// remediation spans to nest under it instead of starting fresh.
+func (qg *QuantumGuard) HandleAnomalyCtx(ctx context.Context, sm reasoners.SystemMetrics) error {
+	return qg.ARF.AutoRemediation.Execute(ctx, sm)
+}
+
+// ActionState looks up a remediation action dispatched by a prior
+// HandleAnomaly/HandleAnomalyCtx call, for the /api/v2/actions/{id} route.
+func (qg *QuantumGuard) ActionState(ctx context.Context, id string) (arf.ActionRecord, error) {
+	return qg.ARF.AutoRemediation.ActionState(ctx, id)
+}
+
+// Close drains in-flight ARF Confirmer goroutines so a graceful shutdown
+// doesn't leak them past process lifetime. It does not stop the HTTP server.
+func (qg *QuantumGuard) Close() {
+	qg.ARF.Close()
+}
@@ -0,0 +1,26 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func init() {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// ExtractHTTP pulls a W3C traceparent header out of r, if present, and
+// returns a context carrying the remote span so the remediation pipeline
+// continues the caller's trace instead of starting a new one.
+func ExtractHTTP(ctx context.Context, r *http.Request) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+}
+
+// InjectHTTP writes ctx's span context into w as a W3C traceparent header,
+// so clients can correlate the response with the server-side trace.
+func InjectHTTP(ctx context.Context, w http.ResponseWriter) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(w.Header()))
+}
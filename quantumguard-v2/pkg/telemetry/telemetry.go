@@ -0,0 +1,31 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer wraps an OTel tracer so callers get a single place to start spans
+// instead of importing otel/trace directly throughout the pipeline.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// MustTracer builds a Tracer for the given service name, registering it as
+// the global tracer provider. It panics if the provider can't be
+// constructed, mirroring finops.MustTagger.
+func MustTracer(ctx context.Context, serviceName string) *Tracer {
+	provider := sdktrace.NewTracerProvider()
+	otel.SetTracerProvider(provider)
+	return &Tracer{tracer: provider.Tracer(serviceName)}
+}
+
+// Start begins a span named name and returns the derived context alongside
+// it, so callers can pass the context to the next stage and later End the
+// span themselves.
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, name)
+}